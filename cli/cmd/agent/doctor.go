@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/hkjarral/asterisk-ai-voice-agent/cli/internal/validator"
+	"github.com/spf13/cobra"
+)
+
+var (
+	doctorAsteriskHost string
+	doctorARIPort      int
+	doctorARIUser      string
+	doctorARIPassword  string
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check connectivity to Asterisk ARI and report actionable diagnostics",
+	Long: `Runs the same ARI reachability and authentication checks as
+'agent quickstart' Step 3, so you can re-verify a running deployment
+without going through the full wizard.`,
+	RunE: runDoctor,
+}
+
+func init() {
+	doctorCmd.Flags().StringVar(&doctorAsteriskHost, "asterisk-host", "localhost", "Asterisk host")
+	doctorCmd.Flags().IntVar(&doctorARIPort, "ari-port", 8088, "ARI port")
+	doctorCmd.Flags().StringVar(&doctorARIUser, "ari-user", "asterisk", "ARI username")
+	doctorCmd.Flags().StringVar(&doctorARIPassword, "ari-password", "", "ARI password")
+	rootCmd.AddCommand(doctorCmd)
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	fmt.Printf("Checking ARI connection to %s:%d...\n", doctorAsteriskHost, doctorARIPort)
+
+	if err := validator.ValidateARI(doctorAsteriskHost, doctorARIPort, doctorARIUser, doctorARIPassword); err != nil {
+		fmt.Println("❌")
+		fmt.Println("")
+		fmt.Printf("ARI connection failed: %v\n", err)
+		return fmt.Errorf("ARI connection failed")
+	}
+
+	fmt.Println("✓ ARI reachable and Stasis event channel confirmed")
+	return nil
+}
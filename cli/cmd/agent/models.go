@@ -0,0 +1,141 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/hkjarral/asterisk-ai-voice-agent/cli/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var modelsDirFlag string
+
+var modelsCmd = &cobra.Command{
+	Use:   "models",
+	Short: "Manage local model bundles for the Local Hybrid provider",
+}
+
+var modelsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List available model bundles and whether they're downloaded",
+	RunE:  runModelsList,
+}
+
+var modelsPullCmd = &cobra.Command{
+	Use:   "pull [bundle...]",
+	Short: "Download model bundles (defaults to the Local Hybrid default set)",
+	RunE:  runModelsPull,
+}
+
+var modelsVerifyCmd = &cobra.Command{
+	Use:   "verify [bundle...]",
+	Short: "Verify the checksum of downloaded model bundles",
+	RunE:  runModelsVerify,
+}
+
+var modelsRmCmd = &cobra.Command{
+	Use:   "rm <bundle>",
+	Short: "Remove a downloaded model bundle",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runModelsRm,
+}
+
+func init() {
+	modelsCmd.PersistentFlags().StringVar(&modelsDirFlag, "models-dir", "models", "Directory to store downloaded model bundles")
+	modelsCmd.AddCommand(modelsListCmd, modelsPullCmd, modelsVerifyCmd, modelsRmCmd)
+	rootCmd.AddCommand(modelsCmd)
+}
+
+func runModelsList(cmd *cobra.Command, args []string) error {
+	for _, bundle := range models.List() {
+		ok, err := models.Verify(modelsDirFlag, bundle.Name)
+		if err != nil {
+			return fmt.Errorf("checking %s: %w", bundle.Name, err)
+		}
+
+		status := "not downloaded"
+		if ok {
+			status = "✓ downloaded"
+		}
+		fmt.Printf("%-28s %10s  %s\n", bundle.Name, formatBytes(bundle.SizeBytes), status)
+	}
+	return nil
+}
+
+func runModelsPull(cmd *cobra.Command, args []string) error {
+	names := args
+	if len(names) == 0 {
+		names = models.DefaultBundleNames()
+	}
+
+	for _, name := range names {
+		fmt.Printf("Pulling %s...\n", name)
+		err := models.EnsureBundle(modelsDirFlag, name, printProgressBar)
+		fmt.Println("")
+		if err != nil {
+			return fmt.Errorf("pulling %s: %w", name, err)
+		}
+		fmt.Printf("✓ %s ready\n", name)
+	}
+	return nil
+}
+
+func runModelsVerify(cmd *cobra.Command, args []string) error {
+	names := args
+	if len(names) == 0 {
+		for _, bundle := range models.List() {
+			names = append(names, bundle.Name)
+		}
+	}
+
+	failed := false
+	for _, name := range names {
+		ok, err := models.Verify(modelsDirFlag, name)
+		if err != nil {
+			return fmt.Errorf("verifying %s: %w", name, err)
+		}
+		if ok {
+			fmt.Printf("✓ %s\n", name)
+		} else {
+			fmt.Printf("❌ %s - missing or checksum mismatch\n", name)
+			failed = true
+		}
+	}
+
+	if failed {
+		return fmt.Errorf("one or more bundles failed verification")
+	}
+	return nil
+}
+
+func runModelsRm(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	if err := models.Remove(modelsDirFlag, name); err != nil {
+		return err
+	}
+	fmt.Printf("✓ removed %s\n", name)
+	return nil
+}
+
+// printProgressBar redraws a single status line as a download's bytes
+// accumulate, matching the wizard's plain terminal output elsewhere.
+func printProgressBar(downloaded, total int64) {
+	if total <= 0 {
+		fmt.Printf("\r  %s downloaded", formatBytes(downloaded))
+		return
+	}
+	percent := float64(downloaded) / float64(total) * 100
+	fmt.Printf("\r  %6.2f%%  %s / %s", percent, formatBytes(downloaded), formatBytes(total))
+}
+
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for q := n / unit; q >= unit; q /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
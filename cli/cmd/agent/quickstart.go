@@ -4,13 +4,41 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
+	"github.com/hkjarral/asterisk-ai-voice-agent/cli/internal/config"
 	"github.com/hkjarral/asterisk-ai-voice-agent/cli/internal/dialplan"
+	"github.com/hkjarral/asterisk-ai-voice-agent/cli/internal/models"
 	"github.com/hkjarral/asterisk-ai-voice-agent/cli/internal/validator"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"gopkg.in/yaml.v3"
 )
 
+const (
+	quickstartEnvPath  = ".env"
+	quickstartYAMLPath = "config/ai-agent.yaml"
+)
+
+// apiKeyEnvVar returns the .env key that holds provider's API key, so the
+// wizard can both look up an existing value to skip re-prompting for it and
+// know where to write a newly entered one.
+func apiKeyEnvVar(provider string) string {
+	switch provider {
+	case "openai_realtime":
+		return "OPENAI_API_KEY"
+	case "deepgram":
+		return "DEEPGRAM_API_KEY"
+	case "google_live":
+		return "GOOGLE_API_KEY"
+	case "azure_openai":
+		return "AZURE_OPENAI_API_KEY"
+	default:
+		return ""
+	}
+}
+
 var quickstartCmd = &cobra.Command{
 	Use:   "quickstart",
 	Short: "Interactive setup wizard for first-time users",
@@ -23,15 +51,196 @@ var quickstartCmd = &cobra.Command{
   6. Dialplan snippet generation
   7. Optional health check
 
-This command is designed for first-time users to get up and running quickly.`,
+This command is designed for first-time users to get up and running quickly.
+
+Every prompt also has a CLI flag, and --from-file can supply all of them at
+once from a YAML file. When stdin is not a terminal, or --yes is passed, the
+wizard runs unattended: no prompt is shown, an answer that has a default
+(host, port, ARI user, Azure API version) takes it, and any other answer
+still missing after flags, --from-file and the existing .env are applied is
+a hard error, which makes it safe to drive from Ansible, Terraform, or a
+container entrypoint.`,
 	RunE: runQuickstart,
 }
 
+var (
+	quickstartProviderFlag        string
+	quickstartAPIKeyFlag          string
+	quickstartAsteriskHostFlag    string
+	quickstartARIPortFlag         int
+	quickstartARIUserFlag         string
+	quickstartARIPasswordFlag     string
+	quickstartAzureBaseURLFlag    string
+	quickstartAzureDeploymentFlag string
+	quickstartAzureModelFlag      string
+	quickstartAzureAPIVersionFlag string
+	quickstartYesFlag             bool
+	quickstartFromFileFlag        string
+	quickstartModelsDirFlag       string
+	quickstartSkipModelsFlag      bool
+)
+
 func init() {
+	flags := quickstartCmd.Flags()
+	flags.StringVar(&quickstartProviderFlag, "provider", "", "Provider to configure (openai_realtime, deepgram, google_live, local_hybrid, azure_openai)")
+	flags.StringVar(&quickstartAPIKeyFlag, "api-key", "", "API key for the selected provider")
+	flags.StringVar(&quickstartAsteriskHostFlag, "asterisk-host", "", "Asterisk host (default localhost)")
+	flags.IntVar(&quickstartARIPortFlag, "ari-port", 0, "ARI port (default 8088)")
+	flags.StringVar(&quickstartARIUserFlag, "ari-user", "", "ARI username (default asterisk)")
+	flags.StringVar(&quickstartARIPasswordFlag, "ari-password", "", "ARI password")
+	flags.StringVar(&quickstartAzureBaseURLFlag, "azure-base-url", "", "Azure OpenAI endpoint, e.g. https://<tenant>.openai.azure.com")
+	flags.StringVar(&quickstartAzureDeploymentFlag, "azure-deployment", "", "Azure OpenAI deployment name")
+	flags.StringVar(&quickstartAzureModelFlag, "azure-model", "", "Azure OpenAI model name")
+	flags.StringVar(&quickstartAzureAPIVersionFlag, "azure-api-version", "", "Azure OpenAI API version (default 2024-10-01-preview)")
+	flags.BoolVar(&quickstartYesFlag, "yes", false, "Run unattended: use defaults, and fail instead of prompting for any answer still missing that has none")
+	flags.StringVar(&quickstartFromFileFlag, "from-file", "", "Path to a quickstart.yaml supplying answers non-interactively")
+	flags.StringVar(&quickstartModelsDirFlag, "models-dir", "models", "Directory to store Local Hybrid model bundles")
+	flags.BoolVar(&quickstartSkipModelsFlag, "skip-model-download", false, "Skip pre-fetching Local Hybrid model bundles")
+
 	rootCmd.AddCommand(quickstartCmd)
 }
 
+// quickstartAnswers holds the answers supplied up front - by --from-file, a
+// flag, or the existing .env - so the rest of runQuickstart doesn't need to
+// care which. Anything still empty is prompted for (or defaulted) later and
+// kept in local variables, not written back here.
+type quickstartAnswers struct {
+	Provider        string `yaml:"provider"`
+	APIKey          string `yaml:"api_key"`
+	AsteriskHost    string `yaml:"asterisk_host"`
+	ARIPort         int    `yaml:"ari_port"`
+	ARIUser         string `yaml:"ari_user"`
+	ARIPassword     string `yaml:"ari_password"`
+	AzureBaseURL    string `yaml:"azure_base_url,omitempty"`
+	AzureDeployment string `yaml:"azure_deployment,omitempty"`
+	AzureModel      string `yaml:"azure_model,omitempty"`
+	AzureAPIVersion string `yaml:"azure_api_version,omitempty"`
+}
+
+func loadQuickstartAnswersFile(path string) (*quickstartAnswers, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	var answers quickstartAnswers
+	if err := yaml.Unmarshal(data, &answers); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return &answers, nil
+}
+
+// applyQuickstartFlags overlays any explicitly-set flag onto answers, taking
+// precedence over whatever --from-file supplied.
+func applyQuickstartFlags(cmd *cobra.Command, answers *quickstartAnswers) {
+	flags := cmd.Flags()
+	if flags.Changed("provider") {
+		answers.Provider = quickstartProviderFlag
+	}
+	if flags.Changed("api-key") {
+		answers.APIKey = quickstartAPIKeyFlag
+	}
+	if flags.Changed("asterisk-host") {
+		answers.AsteriskHost = quickstartAsteriskHostFlag
+	}
+	if flags.Changed("ari-port") {
+		answers.ARIPort = quickstartARIPortFlag
+	}
+	if flags.Changed("ari-user") {
+		answers.ARIUser = quickstartARIUserFlag
+	}
+	if flags.Changed("ari-password") {
+		answers.ARIPassword = quickstartARIPasswordFlag
+	}
+	if flags.Changed("azure-base-url") {
+		answers.AzureBaseURL = quickstartAzureBaseURLFlag
+	}
+	if flags.Changed("azure-deployment") {
+		answers.AzureDeployment = quickstartAzureDeploymentFlag
+	}
+	if flags.Changed("azure-model") {
+		answers.AzureModel = quickstartAzureModelFlag
+	}
+	if flags.Changed("azure-api-version") {
+		answers.AzureAPIVersion = quickstartAzureAPIVersionFlag
+	}
+}
+
+// resolveString returns current if it's already set, otherwise prompts for
+// it (falling back to defaultValue on an empty reply). In non-interactive
+// mode a still-missing value with no default is a hard error instead of a
+// prompt.
+func resolveString(reader *bufio.Reader, nonInteractive bool, current, prompt, defaultValue, fieldName string) (string, error) {
+	if current != "" {
+		return current, nil
+	}
+	if nonInteractive {
+		if defaultValue != "" {
+			return defaultValue, nil
+		}
+		return "", fmt.Errorf("%s is required (pass it via flag or --from-file) when running non-interactively", fieldName)
+	}
+
+	fmt.Print(prompt)
+	value, _ := reader.ReadString('\n')
+	value = strings.TrimSpace(value)
+	if value == "" {
+		value = defaultValue
+	}
+	return value, nil
+}
+
+// applyExistingEnvDefaults fills in answers that are still empty from
+// whatever is already in .env, so re-running the wizard on a configured
+// deployment only prompts for what's actually missing.
+func applyExistingEnvDefaults(answers *quickstartAnswers, existingEnv map[string]string) {
+	if answers.AsteriskHost == "" {
+		answers.AsteriskHost = existingEnv["ASTERISK_HOST"]
+	}
+	if answers.ARIPort == 0 {
+		if port, err := strconv.Atoi(existingEnv["ARI_PORT"]); err == nil {
+			answers.ARIPort = port
+		}
+	}
+	if answers.ARIUser == "" {
+		answers.ARIUser = existingEnv["ARI_USER"]
+	}
+	if answers.ARIPassword == "" {
+		answers.ARIPassword = existingEnv["ARI_PASSWORD"]
+	}
+	if answers.AzureBaseURL == "" {
+		answers.AzureBaseURL = existingEnv["AZURE_OPENAI_BASE_URL"]
+	}
+	if answers.AzureDeployment == "" {
+		answers.AzureDeployment = existingEnv["AZURE_OPENAI_DEPLOYMENT"]
+	}
+	if answers.AzureModel == "" {
+		answers.AzureModel = existingEnv["AZURE_OPENAI_MODEL"]
+	}
+	if answers.AzureAPIVersion == "" {
+		answers.AzureAPIVersion = existingEnv["AZURE_OPENAI_API_VERSION"]
+	}
+}
+
 func runQuickstart(cmd *cobra.Command, args []string) error {
+	nonInteractive := quickstartYesFlag || !term.IsTerminal(int(os.Stdin.Fd()))
+
+	answers := &quickstartAnswers{}
+	if quickstartFromFileFlag != "" {
+		fileAnswers, err := loadQuickstartAnswersFile(quickstartFromFileFlag)
+		if err != nil {
+			return err
+		}
+		answers = fileAnswers
+	}
+	applyQuickstartFlags(cmd, answers)
+
+	existingEnv, err := config.ReadEnv(quickstartEnvPath)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", quickstartEnvPath, err)
+	}
+	applyExistingEnvDefaults(answers, existingEnv)
+
 	fmt.Println("")
 	fmt.Println("╔══════════════════════════════════════════════════════════╗")
 	fmt.Println("║   Asterisk AI Voice Agent - Quickstart Wizard           ║")
@@ -44,77 +253,138 @@ func runQuickstart(cmd *cobra.Command, args []string) error {
 	fmt.Println("  • Generate dialplan configuration")
 	fmt.Println("  • Start Docker containers")
 	fmt.Println("")
-	
+	if nonInteractive {
+		fmt.Println("Running unattended (non-interactive): missing answers without a default are a hard error.")
+		fmt.Println("")
+	}
+
 	reader := bufio.NewReader(os.Stdin)
-	
+
 	// Step 1: Provider Selection
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	fmt.Println("Step 1: Provider Selection")
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	fmt.Println("")
-	fmt.Println("Available providers:")
-	fmt.Println("  1) OpenAI Realtime    - Full-duplex, natural conversations (requires API key)")
-	fmt.Println("  2) Deepgram           - Fast, accurate transcription (requires API key)")
-	fmt.Println("  3) Google Live API    - Multimodal capabilities (requires API key)")
-	fmt.Println("  4) Local Hybrid       - Runs entirely on-premise (no API key needed)")
-	fmt.Println("")
-	
-	fmt.Print("Select provider [1-4]: ")
-	choice, _ := reader.ReadString('\n')
-	choice = strings.TrimSpace(choice)
-	
-	provider := ""
-	needsAPIKey := true
-	
-	switch choice {
-	case "1":
-		provider = "openai_realtime"
-		fmt.Println("✓ Selected: OpenAI Realtime")
-	case "2":
-		provider = "deepgram"
-		fmt.Println("✓ Selected: Deepgram")
-	case "3":
-		provider = "google_live"
-		fmt.Println("✓ Selected: Google Live API")
-	case "4":
-		provider = "local_hybrid"
-		needsAPIKey = false
-		fmt.Println("✓ Selected: Local Hybrid (no API key required)")
+
+	provider := answers.Provider
+	if provider == "" {
+		if nonInteractive {
+			return fmt.Errorf("provider is required (pass it via --provider or --from-file) when running non-interactively")
+		}
+
+		fmt.Println("Available providers:")
+		fmt.Println("  1) OpenAI Realtime    - Full-duplex, natural conversations (requires API key)")
+		fmt.Println("  2) Deepgram           - Fast, accurate transcription (requires API key)")
+		fmt.Println("  3) Google Live API    - Multimodal capabilities (requires API key)")
+		fmt.Println("  4) Local Hybrid       - Runs entirely on-premise (no API key needed)")
+		fmt.Println("  5) Azure OpenAI       - OpenAI models via your Azure tenant (requires API key)")
+		fmt.Println("")
+
+		fmt.Print("Select provider [1-5]: ")
+		choice, _ := reader.ReadString('\n')
+		choice = strings.TrimSpace(choice)
+
+		switch choice {
+		case "1":
+			provider = "openai_realtime"
+		case "2":
+			provider = "deepgram"
+		case "3":
+			provider = "google_live"
+		case "4":
+			provider = "local_hybrid"
+		case "5":
+			provider = "azure_openai"
+		default:
+			return fmt.Errorf("invalid selection: %s", choice)
+		}
+	}
+
+	switch provider {
+	case "openai_realtime", "deepgram", "google_live", "local_hybrid", "azure_openai":
 	default:
-		return fmt.Errorf("invalid selection: %s", choice)
+		return fmt.Errorf("unknown provider: %s", provider)
 	}
-	
+	fmt.Printf("✓ Selected: %s\n", dialplan.GetProviderDisplayName(provider))
+
+	needsAPIKey := provider != "local_hybrid"
+	extraConfig := map[string]string{}
+
 	fmt.Println("")
-	
+
 	// Step 2: API Key Validation (if needed)
-	var apiKey string
+	apiKey := answers.APIKey
+	if apiKey == "" {
+		apiKey = existingEnv[apiKeyEnvVar(provider)]
+	}
 	if needsAPIKey {
 		fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 		fmt.Println("Step 2: API Key Validation")
 		fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 		fmt.Println("")
-		
-		switch provider {
-		case "openai_realtime":
-			fmt.Println("Get your API key from: https://platform.openai.com/api-keys")
-		case "deepgram":
-			fmt.Println("Get your API key from: https://console.deepgram.com/")
-		case "google_live":
-			fmt.Println("Get your API key from: https://console.cloud.google.com/")
+
+		if apiKey == "" && !nonInteractive {
+			switch provider {
+			case "openai_realtime":
+				fmt.Println("Get your API key from: https://platform.openai.com/api-keys")
+			case "deepgram":
+				fmt.Println("Get your API key from: https://console.deepgram.com/")
+			case "google_live":
+				fmt.Println("Get your API key from: https://console.cloud.google.com/")
+			case "azure_openai":
+				fmt.Println("Get your API key from: Azure Portal → your Azure OpenAI resource → Keys and Endpoint")
+			}
+			fmt.Println("")
+		}
+
+		var err error
+		apiKey, err = resolveString(reader, nonInteractive, apiKey, "Enter API key: ", "", "API key")
+		if err != nil {
+			return err
 		}
-		
-		fmt.Println("")
-		fmt.Print("Enter API key: ")
-		apiKey, _ = reader.ReadString('\n')
-		apiKey = strings.TrimSpace(apiKey)
-		
 		if apiKey == "" {
 			return fmt.Errorf("API key cannot be empty")
 		}
-		
+
+		var azureOpts *validator.AzureOptions
+		if provider == "azure_openai" {
+			azureOpts = &validator.AzureOptions{}
+
+			var err error
+			azureOpts.BaseURL, err = resolveString(reader, nonInteractive, strings.TrimRight(answers.AzureBaseURL, "/"), "Azure endpoint (e.g. https://<tenant>.openai.azure.com): ", "", "azure-base-url")
+			if err != nil {
+				return err
+			}
+			azureOpts.BaseURL = strings.TrimRight(azureOpts.BaseURL, "/")
+
+			azureOpts.Deployment, err = resolveString(reader, nonInteractive, answers.AzureDeployment, "Deployment name: ", "", "azure-deployment")
+			if err != nil {
+				return err
+			}
+
+			azureModel, err := resolveString(reader, nonInteractive, answers.AzureModel, "Model name (e.g. gpt-4o-realtime-preview): ", "", "azure-model")
+			if err != nil {
+				return err
+			}
+
+			azureOpts.APIVersion, err = resolveString(reader, nonInteractive, answers.AzureAPIVersion, "API version [2024-10-01-preview]: ", "2024-10-01-preview", "azure-api-version")
+			if err != nil {
+				return err
+			}
+
+			if azureOpts.BaseURL == "" || azureOpts.Deployment == "" {
+				return fmt.Errorf("azure endpoint and deployment name are required")
+			}
+
+			extraConfig["AZURE_OPENAI_BASE_URL"] = azureOpts.BaseURL
+			extraConfig["AZURE_OPENAI_DEPLOYMENT"] = azureOpts.Deployment
+			extraConfig["AZURE_OPENAI_MODEL"] = azureModel
+			extraConfig["AZURE_OPENAI_API_VERSION"] = azureOpts.APIVersion
+		}
+
 		// Validate API key
 		fmt.Print("Validating API key... ")
-		if err := validator.ValidateAPIKey(provider, apiKey); err != nil {
+		if err := validator.ValidateAPIKey(provider, apiKey, azureOpts); err != nil {
 			fmt.Println("❌")
 			fmt.Println("")
 			fmt.Printf("API key validation failed: %v\n", err)
@@ -127,75 +397,177 @@ func runQuickstart(cmd *cobra.Command, args []string) error {
 			fmt.Println("Re-run 'agent quickstart' to try again")
 			return fmt.Errorf("API key validation failed")
 		}
-		
+
 		fmt.Println("✓")
 		fmt.Println("")
+	} else if provider == "local_hybrid" {
+		fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+		fmt.Println("Step 2: Model Bundles")
+		fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+		fmt.Println("")
+		fmt.Println("Local Hybrid runs entirely on-premise and needs STT/TTS/LLM model")
+		fmt.Println("bundles on disk before the first call - downloading them now avoids")
+		fmt.Println("a stall on that first call.")
+		fmt.Println("")
+
+		fetchModels := !quickstartSkipModelsFlag
+		if fetchModels && !nonInteractive {
+			fmt.Print("Pre-fetch default model bundles now? [Y/n]: ")
+			confirm, _ := reader.ReadString('\n')
+			confirm = strings.TrimSpace(confirm)
+			fetchModels = strings.ToLower(confirm) != "n"
+		}
+
+		if fetchModels {
+			for _, name := range models.DefaultBundleNames() {
+				fmt.Printf("Pulling %s...\n", name)
+				err := models.EnsureBundle(quickstartModelsDirFlag, name, printProgressBar)
+				fmt.Println("")
+				if err != nil {
+					return fmt.Errorf("pulling %s: %w", name, err)
+				}
+				fmt.Printf("✓ %s ready\n", name)
+			}
+		} else {
+			fmt.Println("Skipping model downloads - run 'agent models pull' before your first call.")
+		}
+		fmt.Println("")
 	}
-	
+
 	// Step 3: ARI Connection
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	fmt.Println("Step 3: Asterisk ARI Connection")
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	fmt.Println("")
-	
-	fmt.Print("Asterisk host [localhost]: ")
-	asteriskHost, _ := reader.ReadString('\n')
-	asteriskHost = strings.TrimSpace(asteriskHost)
-	if asteriskHost == "" {
-		asteriskHost = "localhost"
-	}
-	
-	fmt.Print("ARI username [asterisk]: ")
-	ariUser, _ := reader.ReadString('\n')
-	ariUser = strings.TrimSpace(ariUser)
-	if ariUser == "" {
-		ariUser = "asterisk"
-	}
-	
-	fmt.Print("ARI password: ")
-	ariPassword, _ := reader.ReadString('\n')
-	ariPassword = strings.TrimSpace(ariPassword)
-	
+
+	asteriskHost, err := resolveString(reader, nonInteractive, answers.AsteriskHost, "Asterisk host [localhost]: ", "localhost", "asterisk-host")
+	if err != nil {
+		return err
+	}
+
+	ariPort := answers.ARIPort
+	if ariPort == 0 {
+		if nonInteractive {
+			ariPort = 8088
+		} else {
+			fmt.Print("ARI port [8088]: ")
+			ariPortInput, _ := reader.ReadString('\n')
+			ariPortInput = strings.TrimSpace(ariPortInput)
+			ariPort = 8088
+			if ariPortInput != "" {
+				parsedPort, err := strconv.Atoi(ariPortInput)
+				if err != nil {
+					return fmt.Errorf("invalid ARI port: %s", ariPortInput)
+				}
+				ariPort = parsedPort
+			}
+		}
+	}
+
+	ariUser, err := resolveString(reader, nonInteractive, answers.ARIUser, "ARI username [asterisk]: ", "asterisk", "ari-user")
+	if err != nil {
+		return err
+	}
+
+	ariPassword, err := resolveString(reader, nonInteractive, answers.ARIPassword, "ARI password: ", "", "ari-password")
+	if err != nil {
+		return err
+	}
+
 	fmt.Println("")
 	fmt.Printf("Testing ARI connection to %s...\n", asteriskHost)
-	fmt.Println("⚠️  ARI validation not yet implemented in quickstart")
-	fmt.Println("   Connection will be tested when containers start")
+	if err := validator.ValidateARI(asteriskHost, ariPort, ariUser, ariPassword); err != nil {
+		fmt.Println("❌")
+		fmt.Println("")
+		fmt.Printf("ARI connection failed: %v\n", err)
+		fmt.Println("")
+		fmt.Println("Please check:")
+		fmt.Println("  • Asterisk is running and reachable at this host/port")
+		fmt.Println("  • ari.conf has a matching user with read/write permissions")
+		fmt.Println("  • ARI is enabled ([general] enabled=yes in ari.conf)")
+		fmt.Println("")
+		return fmt.Errorf("ARI connection failed")
+	}
+	fmt.Println("✓ ARI reachable and Stasis event channel confirmed")
 	fmt.Println("")
-	
+
 	// Step 4: Generate Configuration
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	fmt.Println("Step 4: Configuration")
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	fmt.Println("")
-	
-	fmt.Println("Configuration will be saved to:")
-	fmt.Println("  • .env (credentials)")
-	fmt.Println("  • config/ai-agent.yaml (AI settings)")
-	fmt.Println("")
-	
-	fmt.Print("Continue? [Y/n]: ")
-	confirm, _ := reader.ReadString('\n')
-	confirm = strings.TrimSpace(confirm)
-	
-	if strings.ToLower(confirm) == "n" {
-		fmt.Println("Quickstart cancelled")
-		return nil
-	}
-	
+
+	fmt.Printf("Configuration will be saved to:\n")
+	fmt.Printf("  • %s (credentials)\n", quickstartEnvPath)
+	fmt.Printf("  • %s (AI settings)\n", quickstartYAMLPath)
+	if len(extraConfig) > 0 {
+		fmt.Printf("  • %d additional %s setting(s) captured in Step 2\n", len(extraConfig), dialplan.GetProviderDisplayName(provider))
+	}
+	fmt.Println("")
+
+	if !nonInteractive {
+		fmt.Print("Continue? [Y/n]: ")
+		confirm, _ := reader.ReadString('\n')
+		confirm = strings.TrimSpace(confirm)
+
+		if strings.ToLower(confirm) == "n" {
+			fmt.Println("Quickstart cancelled")
+			return nil
+		}
+	}
+
 	// Save configuration
-	fmt.Println("⚠️  Configuration generation not yet fully implemented")
-	fmt.Println("   Please use 'agent init' or edit files manually")
+	envKV := map[string]string{
+		"ASTERISK_HOST": asteriskHost,
+		"ARI_PORT":      strconv.Itoa(ariPort),
+		"ARI_USER":      ariUser,
+		"ARI_PASSWORD":  ariPassword,
+	}
+	if needsAPIKey {
+		envKV[apiKeyEnvVar(provider)] = apiKey
+	}
+	for key, value := range extraConfig {
+		envKV[key] = value
+	}
+
+	if err := config.WriteEnv(quickstartEnvPath, envKV); err != nil {
+		return fmt.Errorf("writing %s: %w", quickstartEnvPath, err)
+	}
+
+	agentCfg := config.AgentConfig{}
+	switch provider {
+	case "openai_realtime":
+		agentCfg.OpenAIRealtime = &config.OpenAIRealtimeConfig{}
+	case "deepgram":
+		agentCfg.Deepgram = &config.DeepgramConfig{}
+	case "google_live":
+		agentCfg.GoogleLive = &config.GoogleLiveConfig{}
+	case "local_hybrid":
+		agentCfg.LocalHybrid = &config.LocalHybridConfig{ModelsDir: quickstartModelsDirFlag}
+	case "azure_openai":
+		agentCfg.AzureOpenAI = &config.AzureOpenAIConfig{
+			BaseURL:    extraConfig["AZURE_OPENAI_BASE_URL"],
+			Deployment: extraConfig["AZURE_OPENAI_DEPLOYMENT"],
+			Model:      extraConfig["AZURE_OPENAI_MODEL"],
+			APIVersion: extraConfig["AZURE_OPENAI_API_VERSION"],
+		}
+	}
+	if err := config.WriteAgentYAML(quickstartYAMLPath, agentCfg); err != nil {
+		return fmt.Errorf("writing %s: %w", quickstartYAMLPath, err)
+	}
+
+	fmt.Printf("✓ Wrote %s and %s\n", quickstartEnvPath, quickstartYAMLPath)
 	fmt.Println("")
-	
+
 	// Step 5: Dialplan Generation
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	fmt.Println("Step 5: Dialplan Configuration")
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	fmt.Println("")
-	
+
 	snippet := dialplan.GenerateSnippet(provider)
 	providerName := dialplan.GetProviderDisplayName(provider)
-	
+
 	fmt.Printf("Add this dialplan snippet to /etc/asterisk/extensions_custom.conf:\n")
 	fmt.Println("")
 	fmt.Println("────────────────────────────────────────────────────────────")
@@ -210,7 +582,7 @@ func runQuickstart(cmd *cobra.Command, args []string) error {
 	fmt.Printf("     Target: %s,s,1\n", contextName)
 	fmt.Printf("     Description: AI Voice Agent - %s\n", providerName)
 	fmt.Println("")
-	
+
 	// Step 6: Summary
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	fmt.Println("Setup Complete!")
@@ -228,7 +600,7 @@ func runQuickstart(cmd *cobra.Command, args []string) error {
 	fmt.Println("  docs/CLI_TOOLS_GUIDE.md")
 	fmt.Println("  docs/FreePBX-Integration-Guide.md")
 	fmt.Println("")
-	
+
 	return nil
 }
 
@@ -238,8 +610,9 @@ func getContextName(provider string) string {
 		"deepgram":        "from-ai-agent-deepgram",
 		"local_hybrid":    "from-ai-agent-hybrid",
 		"google_live":     "from-ai-agent-google",
+		"azure_openai":    "from-ai-agent-azure",
 	}
-	
+
 	if ctx, ok := contexts[provider]; ok {
 		return ctx
 	}
@@ -0,0 +1,148 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string // "" means no pre-existing file
+		kv       map[string]string
+		want     string
+	}{
+		{
+			name:     "creates a new file sorted by key",
+			existing: "",
+			kv:       map[string]string{"OPENAI_API_KEY": "sk-test", "ASTERISK_HOST": "localhost"},
+			want:     "ASTERISK_HOST=localhost\nOPENAI_API_KEY=sk-test\n",
+		},
+		{
+			name:     "preserves comments, blank lines, and unrelated keys",
+			existing: "# managed by quickstart\nASTERISK_HOST=localhost\n\nUNRELATED=keep-me\n",
+			kv:       map[string]string{"OPENAI_API_KEY": "sk-test"},
+			want:     "# managed by quickstart\nASTERISK_HOST=localhost\n\nUNRELATED=keep-me\nOPENAI_API_KEY=sk-test\n",
+		},
+		{
+			name:     "overwrites only the touched key in place",
+			existing: "ASTERISK_HOST=old-host\nOPENAI_API_KEY=sk-old\n",
+			kv:       map[string]string{"ASTERISK_HOST": "new-host"},
+			want:     "ASTERISK_HOST=new-host\nOPENAI_API_KEY=sk-old\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, ".env")
+			if tt.existing != "" {
+				if err := os.WriteFile(path, []byte(tt.existing), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := WriteEnv(path, tt.kv); err != nil {
+				t.Fatalf("WriteEnv: %v", err)
+			}
+
+			got, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("reading result: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got:\n%q\nwant:\n%q", got, tt.want)
+			}
+
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if perm := info.Mode().Perm(); perm != 0o600 {
+				t.Errorf("permissions = %o, want 0600", perm)
+			}
+		})
+	}
+}
+
+func TestWriteEnv_BacksUpExistingFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ".env")
+	original := "ASTERISK_HOST=localhost\n"
+	if err := os.WriteFile(path, []byte(original), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteEnv(path, map[string]string{"ASTERISK_HOST": "new-host"}); err != nil {
+		t.Fatalf("WriteEnv: %v", err)
+	}
+
+	backup, err := os.ReadFile(path + ".bak")
+	if err != nil {
+		t.Fatalf("reading backup: %v", err)
+	}
+	if string(backup) != original {
+		t.Errorf("backup = %q, want original content %q", backup, original)
+	}
+}
+
+func TestWriteAgentYAML(t *testing.T) {
+	existing := "# hand-edited\ndeepgram:\n    model: nova-2\n"
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "ai-agent.yaml")
+	if err := os.WriteFile(path, []byte(existing), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := AgentConfig{
+		OpenAIRealtime: &OpenAIRealtimeConfig{Model: "gpt-4o-realtime", Voice: "alloy"},
+	}
+	if err := WriteAgentYAML(path, cfg); err != nil {
+		t.Fatalf("WriteAgentYAML: %v", err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading result: %v", err)
+	}
+
+	if !strings.Contains(string(got), "deepgram:") || !strings.Contains(string(got), "nova-2") {
+		t.Errorf("unrelated deepgram section was not preserved, got:\n%s", got)
+	}
+	if !strings.Contains(string(got), "openai_realtime:") || !strings.Contains(string(got), "gpt-4o-realtime") {
+		t.Errorf("new openai_realtime section was not written, got:\n%s", got)
+	}
+
+	if _, err := os.Stat(path + ".bak"); err != nil {
+		t.Errorf("expected a .bak of the pre-existing file: %v", err)
+	}
+}
+
+func TestWriteAgentYAML_StableFieldOrdering(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "ai-agent.yaml")
+	cfg := AgentConfig{OpenAIRealtime: &OpenAIRealtimeConfig{Model: "gpt-4o-realtime", Voice: "alloy"}}
+
+	if err := WriteAgentYAML(path, cfg); err != nil {
+		t.Fatalf("WriteAgentYAML (first run): %v", err)
+	}
+	first, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteAgentYAML(path, cfg); err != nil {
+		t.Fatalf("WriteAgentYAML (second run): %v", err)
+	}
+	second, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(first) != string(second) {
+		t.Errorf("re-marshaling the same config produced different output:\nfirst:\n%s\nsecond:\n%s", first, second)
+	}
+}
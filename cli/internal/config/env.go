@@ -0,0 +1,108 @@
+package config
+
+import (
+	"os"
+	"sort"
+	"strings"
+)
+
+// envFile is a line-oriented .env representation so comments, blank lines,
+// and key ordering from an existing file survive a merge untouched.
+type envFile struct {
+	lines  []string
+	lineOf map[string]int
+}
+
+func loadEnvFile(path string) (*envFile, error) {
+	ef := &envFile{lineOf: map[string]int{}}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ef, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ef.lines = strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	for i, line := range ef.lines {
+		key, ok := envKey(line)
+		if ok {
+			ef.lineOf[key] = i
+		}
+	}
+	return ef, nil
+}
+
+func envKey(line string) (string, bool) {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+		return "", false
+	}
+	key, _, ok := strings.Cut(trimmed, "=")
+	if !ok {
+		return "", false
+	}
+	return strings.TrimSpace(key), true
+}
+
+func (ef *envFile) set(key, value string) {
+	line := key + "=" + value
+	if idx, ok := ef.lineOf[key]; ok {
+		ef.lines[idx] = line
+		return
+	}
+	ef.lines = append(ef.lines, line)
+	ef.lineOf[key] = len(ef.lines) - 1
+}
+
+func (ef *envFile) render() []byte {
+	if len(ef.lines) == 0 {
+		return nil
+	}
+	return []byte(strings.Join(ef.lines, "\n") + "\n")
+}
+
+// ReadEnv returns the key/value pairs currently in path, or an empty map if
+// the file doesn't exist yet. It's used by the wizard to skip prompting for
+// values that are already configured.
+func ReadEnv(path string) (map[string]string, error) {
+	ef, err := loadEnvFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	kv := make(map[string]string, len(ef.lineOf))
+	for key, idx := range ef.lineOf {
+		_, value, _ := strings.Cut(ef.lines[idx], "=")
+		kv[key] = value
+	}
+	return kv, nil
+}
+
+// WriteEnv merges kv into the .env file at path, preserving unrelated keys,
+// comments, and ordering, then atomically replaces it with 0600 permissions.
+// If path already exists it is backed up to path+".bak" first.
+func WriteEnv(path string, kv map[string]string) error {
+	ef, err := loadEnvFile(path)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(kv))
+	for key := range kv {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		ef.set(key, kv[key])
+	}
+
+	if fileExists(path) {
+		if err := backupExisting(path); err != nil {
+			return err
+		}
+	}
+
+	return atomicWriteFile(path, ef.render(), 0o600)
+}
@@ -0,0 +1,46 @@
+// Package config writes the quickstart wizard's answers to .env and
+// config/ai-agent.yaml, merging into whatever is already on disk instead of
+// clobbering it, so re-running the wizard is safe on a live deployment.
+package config
+
+// AgentConfig is the root of config/ai-agent.yaml. Only the section for the
+// provider the caller is configuring needs to be set - WriteAgentYAML
+// leaves every other section in the file untouched.
+type AgentConfig struct {
+	OpenAIRealtime *OpenAIRealtimeConfig `yaml:"openai_realtime,omitempty"`
+	Deepgram       *DeepgramConfig       `yaml:"deepgram,omitempty"`
+	GoogleLive     *GoogleLiveConfig     `yaml:"google_live,omitempty"`
+	LocalHybrid    *LocalHybridConfig    `yaml:"local_hybrid,omitempty"`
+	AzureOpenAI    *AzureOpenAIConfig    `yaml:"azure_openai,omitempty"`
+}
+
+// OpenAIRealtimeConfig holds the vanilla OpenAI Realtime API settings.
+type OpenAIRealtimeConfig struct {
+	Model string `yaml:"model,omitempty"`
+	Voice string `yaml:"voice,omitempty"`
+}
+
+// DeepgramConfig holds Deepgram transcription settings.
+type DeepgramConfig struct {
+	Model string `yaml:"model,omitempty"`
+}
+
+// GoogleLiveConfig holds Google Live API settings.
+type GoogleLiveConfig struct {
+	Model string `yaml:"model,omitempty"`
+}
+
+// LocalHybridConfig holds settings for the fully on-premise provider.
+type LocalHybridConfig struct {
+	ModelsDir string `yaml:"models_dir,omitempty"`
+}
+
+// AzureOpenAIConfig holds the per-tenant details an Azure OpenAI deployment
+// needs beyond an API key: endpoint, deployment name, model, and API
+// version all vary per Azure resource.
+type AzureOpenAIConfig struct {
+	BaseURL    string `yaml:"base_url"`
+	Deployment string `yaml:"deployment"`
+	Model      string `yaml:"model,omitempty"`
+	APIVersion string `yaml:"api_version"`
+}
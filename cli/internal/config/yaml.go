@@ -0,0 +1,102 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// WriteAgentYAML merges cfg's non-nil provider sections into the YAML file
+// at path, leaving every other section - and any comments in it - alone.
+// Each section is a typed struct so yaml.Marshal produces stable field
+// ordering across runs. Like WriteEnv, an existing file is backed up to
+// path+".bak" before being atomically replaced.
+func WriteAgentYAML(path string, cfg AgentConfig) error {
+	doc, err := loadYAMLDocument(path)
+	if err != nil {
+		return err
+	}
+	root := doc.Content[0]
+
+	sections := []struct {
+		key   string
+		value interface{}
+	}{
+		{"openai_realtime", cfg.OpenAIRealtime},
+		{"deepgram", cfg.Deepgram},
+		{"google_live", cfg.GoogleLive},
+		{"local_hybrid", cfg.LocalHybrid},
+		{"azure_openai", cfg.AzureOpenAI},
+	}
+	for _, section := range sections {
+		if section.value == nil {
+			continue
+		}
+		if err := setMappingSection(root, section.key, section.value); err != nil {
+			return fmt.Errorf("encoding %s section: %w", section.key, err)
+		}
+	}
+
+	out, err := yaml.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("marshaling %s: %w", path, err)
+	}
+
+	if fileExists(path) {
+		if err := backupExisting(path); err != nil {
+			return err
+		}
+	}
+
+	return atomicWriteFile(path, out, 0o644)
+}
+
+func loadYAMLDocument(path string) (*yaml.Node, error) {
+	emptyDoc := func() *yaml.Node {
+		return &yaml.Node{
+			Kind:    yaml.DocumentNode,
+			Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}},
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return emptyDoc(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	if len(data) == 0 {
+		return emptyDoc(), nil
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if doc.Kind == 0 || len(doc.Content) == 0 {
+		return emptyDoc(), nil
+	}
+	return &doc, nil
+}
+
+// setMappingSection replaces the value node for key in a YAML mapping node,
+// or appends a new key/value pair if key isn't present yet.
+func setMappingSection(root *yaml.Node, key string, value interface{}) error {
+	valueNode := &yaml.Node{}
+	if err := valueNode.Encode(value); err != nil {
+		return err
+	}
+
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == key {
+			root.Content[i+1] = valueNode
+			return nil
+		}
+	}
+
+	keyNode := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}
+	root.Content = append(root.Content, keyNode, valueNode)
+	return nil
+}
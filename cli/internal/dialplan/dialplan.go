@@ -0,0 +1,53 @@
+// Package dialplan generates the Asterisk dialplan snippets the quickstart
+// wizard prints so operators can wire a DID straight into the AI agent's
+// Stasis application without hand-writing extensions_custom.conf.
+package dialplan
+
+import "fmt"
+
+// GenerateSnippet returns a ready-to-paste extensions_custom.conf block that
+// routes calls into the Stasis application for the given provider.
+func GenerateSnippet(provider string) string {
+	context := contextName(provider)
+	return fmt.Sprintf(`[%s]
+exten => s,1,NoOp(AI Voice Agent - %s)
+ same => n,Answer()
+ same => n,Stasis(ai-voice-agent,%s)
+ same => n,Hangup()`, context, GetProviderDisplayName(provider), provider)
+}
+
+// GetProviderDisplayName returns the human-readable name shown in wizard
+// output and FreePBX custom destination descriptions.
+func GetProviderDisplayName(provider string) string {
+	switch provider {
+	case "openai_realtime":
+		return "OpenAI Realtime"
+	case "deepgram":
+		return "Deepgram"
+	case "google_live":
+		return "Google Live API"
+	case "local_hybrid":
+		return "Local Hybrid"
+	case "azure_openai":
+		return "Azure OpenAI"
+	default:
+		return provider
+	}
+}
+
+func contextName(provider string) string {
+	switch provider {
+	case "openai_realtime":
+		return "from-ai-agent-openai"
+	case "deepgram":
+		return "from-ai-agent-deepgram"
+	case "local_hybrid":
+		return "from-ai-agent-hybrid"
+	case "google_live":
+		return "from-ai-agent-google"
+	case "azure_openai":
+		return "from-ai-agent-azure"
+	default:
+		return "from-ai-agent"
+	}
+}
@@ -0,0 +1,205 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	downloadTimeout       = 30 * time.Minute
+	checksumLookupTimeout = 15 * time.Second
+)
+
+// Progress reports bytes downloaded so far against the bundle's expected
+// total size. It's called repeatedly during a download, so implementations
+// should be cheap (e.g. redraw a single progress line).
+type Progress func(downloaded, total int64)
+
+// EnsureBundle downloads name into modelsDir if it isn't already present
+// with a valid checksum, resuming a previous partial download via an HTTP
+// Range request when possible. progress may be nil.
+func EnsureBundle(modelsDir, name string, progress Progress) error {
+	bundle, ok := Get(name)
+	if !ok {
+		return fmt.Errorf("unknown model bundle: %s", name)
+	}
+
+	expected, err := expectedSHA256(bundle)
+	if err != nil {
+		return fmt.Errorf("resolving checksum for %s: %w", name, err)
+	}
+
+	path := filepath.Join(modelsDir, bundle.RelPath)
+	if ok, err := verifyChecksum(path, expected); err == nil && ok {
+		if progress != nil {
+			progress(bundle.SizeBytes, bundle.SizeBytes)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating %s: %w", filepath.Dir(path), err)
+	}
+
+	if err := downloadResumable(path, bundle, progress); err != nil {
+		return err
+	}
+
+	ok, err := verifyChecksum(path, expected)
+	if err != nil {
+		return fmt.Errorf("verifying %s: %w", name, err)
+	}
+	if !ok {
+		os.Remove(path)
+		return fmt.Errorf("checksum mismatch for %s after download - file removed, please retry", name)
+	}
+	return nil
+}
+
+// Verify reports whether name is already downloaded into modelsDir with a
+// matching checksum.
+func Verify(modelsDir, name string) (bool, error) {
+	bundle, ok := Get(name)
+	if !ok {
+		return false, fmt.Errorf("unknown model bundle: %s", name)
+	}
+	expected, err := expectedSHA256(bundle)
+	if err != nil {
+		return false, fmt.Errorf("resolving checksum for %s: %w", name, err)
+	}
+	return verifyChecksum(filepath.Join(modelsDir, bundle.RelPath), expected)
+}
+
+// expectedSHA256 returns the checksum a downloaded bundle must match. A
+// maintainer-pinned Bundle.SHA256 always wins; when a bundle has none yet,
+// the canonical digest is read from the host instead of guessed, since
+// Hugging Face publishes the sha256 of every LFS-tracked file as the
+// X-Linked-Etag header on both HEAD and GET responses.
+func expectedSHA256(bundle Bundle) (string, error) {
+	if bundle.SHA256 != "" {
+		return bundle.SHA256, nil
+	}
+
+	req, err := http.NewRequest(http.MethodHead, bundle.URL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	client := &http.Client{Timeout: checksumLookupTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("checking %s: %w", bundle.URL, err)
+	}
+	defer resp.Body.Close()
+
+	etag := strings.Trim(resp.Header.Get("X-Linked-Etag"), `"`)
+	if len(etag) != 64 {
+		return "", fmt.Errorf("host did not publish a usable checksum for %s (X-Linked-Etag missing or malformed)", bundle.Name)
+	}
+	return etag, nil
+}
+
+// Remove deletes a previously downloaded bundle from modelsDir, if present.
+func Remove(modelsDir, name string) error {
+	bundle, ok := Get(name)
+	if !ok {
+		return fmt.Errorf("unknown model bundle: %s", name)
+	}
+
+	path := filepath.Join(modelsDir, bundle.RelPath)
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing %s: %w", path, err)
+	}
+	return nil
+}
+
+func downloadResumable(path string, bundle Bundle, progress Progress) error {
+	var startAt int64
+	if info, err := os.Stat(path); err == nil {
+		startAt = info.Size()
+	}
+	if startAt >= bundle.SizeBytes {
+		startAt = 0
+	}
+
+	req, err := http.NewRequest(http.MethodGet, bundle.URL, nil)
+	if err != nil {
+		return err
+	}
+	if startAt > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", startAt))
+	}
+
+	client := &http.Client{Timeout: downloadTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("downloading %s: %w", bundle.Name, err)
+	}
+	defer resp.Body.Close()
+
+	flags := os.O_CREATE | os.O_WRONLY
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		flags |= os.O_APPEND
+	case http.StatusOK:
+		// Server ignored our Range request (or there was nothing to resume) -
+		// start the file over from scratch.
+		startAt = 0
+		flags |= os.O_TRUNC
+	default:
+		return fmt.Errorf("downloading %s: unexpected HTTP %d", bundle.Name, resp.StatusCode)
+	}
+
+	file, err := os.OpenFile(path, flags, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", path, err)
+	}
+	defer file.Close()
+
+	downloaded := startAt
+	buf := make([]byte, 256*1024)
+	for {
+		n, readErr := resp.Body.Read(buf)
+		if n > 0 {
+			if _, writeErr := file.Write(buf[:n]); writeErr != nil {
+				return fmt.Errorf("writing %s: %w", path, writeErr)
+			}
+			downloaded += int64(n)
+			if progress != nil {
+				progress(downloaded, bundle.SizeBytes)
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return fmt.Errorf("downloading %s: %w", bundle.Name, readErr)
+		}
+	}
+	return nil
+}
+
+func verifyChecksum(path, expectedSHA256 string) (bool, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return false, err
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)) == expectedSHA256, nil
+}
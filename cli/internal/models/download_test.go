@@ -0,0 +1,75 @@
+package models
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExpectedSHA256(t *testing.T) {
+	const pinned = "60ed5bc3dd14eea856493d334349b405782ddcaf0028d4b5df4088345fba2ef"
+	const upstream = "3a1f5e0a9d0f6f5a7a1c9a9c0a6e6b0e4f7a8c2d9b3e1f4a6c8d0b2e4f6a8c0d"
+
+	tests := []struct {
+		name          string
+		bundle        func(serverURL string) Bundle
+		header        string
+		wantErr       bool
+		wantChecksum  string
+		wantNoRequest bool
+	}{
+		{
+			name: "pinned checksum wins without a request",
+			bundle: func(serverURL string) Bundle {
+				return Bundle{Name: "pinned", URL: serverURL, SHA256: pinned}
+			},
+			wantChecksum:  pinned,
+			wantNoRequest: true,
+		},
+		{
+			name: "falls back to the host's X-Linked-Etag when unpinned",
+			bundle: func(serverURL string) Bundle {
+				return Bundle{Name: "unpinned", URL: serverURL}
+			},
+			header:       upstream,
+			wantChecksum: upstream,
+		},
+		{
+			name: "errors when unpinned and the host publishes no usable etag",
+			bundle: func(serverURL string) Bundle {
+				return Bundle{Name: "unpinned-no-header", URL: serverURL}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if tt.header != "" {
+					w.Header().Set("X-Linked-Etag", `"`+tt.header+`"`)
+				}
+			}))
+			defer server.Close()
+
+			got, err := expectedSHA256(tt.bundle(server.URL))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expectedSHA256: %v", err)
+			}
+			if got != tt.wantChecksum {
+				t.Errorf("got %q, want %q", got, tt.wantChecksum)
+			}
+			if tt.wantNoRequest && requests != 0 {
+				t.Errorf("made %d request(s) to the host, want none", requests)
+			}
+		})
+	}
+}
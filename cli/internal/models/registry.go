@@ -0,0 +1,71 @@
+// Package models manages the STT/TTS/LLM model bundles the Local Hybrid
+// provider runs on-premise. It fetches named bundles into a local models
+// directory so the first call doesn't stall on a multi-gigabyte download.
+package models
+
+import "sort"
+
+// Bundle describes one downloadable model file.
+type Bundle struct {
+	Name string
+	// URL is the HTTPS location the bundle is fetched from.
+	URL string
+	// SHA256 is the expected checksum of the fully downloaded file, hex
+	// encoded. It may be empty: not every bundle has a maintainer-reviewed
+	// pin yet, and an empty value tells the downloader to fall back to the
+	// canonical digest the host publishes (see expectedSHA256 in
+	// download.go) rather than fail every pull against a guessed constant.
+	SHA256 string
+	// SizeBytes is the expected size of the fully downloaded file.
+	SizeBytes int64
+	// RelPath is where the bundle lives under a models directory.
+	RelPath string
+}
+
+var registry = map[string]Bundle{
+	"whisper-base": {
+		Name:      "whisper-base",
+		URL:       "https://huggingface.co/ggerganov/whisper.cpp/resolve/main/ggml-base.bin",
+		SizeBytes: 147_964_211,
+		RelPath:   "stt/whisper-base.bin",
+	},
+	"piper-en_US-amy": {
+		Name:      "piper-en_US-amy",
+		URL:       "https://huggingface.co/rhasspy/piper-voices/resolve/main/en/en_US/amy/medium/en_US-amy-medium.onnx",
+		SizeBytes: 63_201_040,
+		RelPath:   "tts/piper-en_US-amy-medium.onnx",
+	},
+	"llama-3.1-8b-instruct-q4": {
+		Name:      "llama-3.1-8b-instruct-q4",
+		URL:       "https://huggingface.co/meta-llama/Meta-Llama-3.1-8B-Instruct-GGUF/resolve/main/llama-3.1-8b-instruct.Q4_K_M.gguf",
+		SizeBytes: 4_920_738_816,
+		RelPath:   "llm/llama-3.1-8b-instruct-q4.gguf",
+	},
+}
+
+// DefaultBundleNames are the bundles quickstart pre-fetches for Local Hybrid
+// so the first call has everything it needs already on disk.
+func DefaultBundleNames() []string {
+	return []string{"whisper-base", "piper-en_US-amy", "llama-3.1-8b-instruct-q4"}
+}
+
+// Get looks up a bundle by name.
+func Get(name string) (Bundle, bool) {
+	bundle, ok := registry[name]
+	return bundle, ok
+}
+
+// List returns every registered bundle, sorted by name.
+func List() []Bundle {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	bundles := make([]Bundle, 0, len(names))
+	for _, name := range names {
+		bundles = append(bundles, registry[name])
+	}
+	return bundles
+}
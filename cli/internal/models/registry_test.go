@@ -0,0 +1,45 @@
+package models
+
+import "testing"
+
+// TestBundleSHA256IsWellFormed catches typo'd checksums at test time instead
+// of letting them silently fail every download with "checksum mismatch". An
+// empty SHA256 is allowed: it means the bundle has no maintainer-reviewed pin
+// yet and falls back to the digest expectedSHA256 reads from the host.
+func TestBundleSHA256IsWellFormed(t *testing.T) {
+	for _, bundle := range List() {
+		if bundle.SHA256 == "" {
+			continue
+		}
+		if len(bundle.SHA256) != 64 {
+			t.Errorf("%s: SHA256 is %d hex chars, want 64: %q", bundle.Name, len(bundle.SHA256), bundle.SHA256)
+		}
+		for _, c := range bundle.SHA256 {
+			if !isHexDigit(c) {
+				t.Errorf("%s: SHA256 contains non-hex character %q: %q", bundle.Name, c, bundle.SHA256)
+				break
+			}
+		}
+	}
+}
+
+func isHexDigit(c rune) bool {
+	switch {
+	case c >= '0' && c <= '9':
+		return true
+	case c >= 'a' && c <= 'f':
+		return true
+	case c >= 'A' && c <= 'F':
+		return true
+	default:
+		return false
+	}
+}
+
+func TestDefaultBundleNamesAreRegistered(t *testing.T) {
+	for _, name := range DefaultBundleNames() {
+		if _, ok := Get(name); !ok {
+			t.Errorf("DefaultBundleNames references unregistered bundle %q", name)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package validator
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// ariProbeApp is the Stasis application name used for the short-lived
+// websocket probe. It never needs to be registered ahead of time - ARI
+// creates the app dynamically on first subscribe - so a failure to reach it
+// points at ARI being disabled or misconfigured rather than a missing app.
+const ariProbeApp = "quickstart-probe"
+
+const ariWebSocketTimeout = 3 * time.Second
+
+// ValidateARI confirms that Asterisk's ARI is reachable and the supplied
+// credentials are accepted. It first performs an HTTP GET against
+// /ari/asterisk/info with basic auth, then opens the /ari/events websocket
+// used by the voice agent to confirm the Stasis event channel comes up,
+// closing as soon as the channel proves reachable or after a short timeout.
+// It is used by both `agent quickstart` and `agent doctor` so the two
+// commands report identical diagnostics.
+//
+// It distinguishes DNS failure, connection refused, HTTP 401, and websocket
+// upgrade failure. It does not report a separate "app not registered"
+// diagnostic: ariProbeApp is a dynamic Stasis app that Asterisk creates on
+// first subscribe rather than something declared in ari.conf ahead of time,
+// so there is no missing-registration failure mode distinct from the ones
+// already listed above.
+func ValidateARI(host string, port int, user, pass string) error {
+	if err := checkARIInfo(host, port, user, pass); err != nil {
+		return err
+	}
+	return checkARIEventsChannel(host, port, user, pass)
+}
+
+func checkARIInfo(host string, port int, user, pass string) error {
+	url := fmt.Sprintf("http://%s:%d/ari/asterisk/info", host, port)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(user, pass)
+
+	client := &http.Client{Timeout: defaultTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return classifyARIDialError(err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("ARI authentication failed (HTTP %d) - check the ari.conf user/password", resp.StatusCode)
+	default:
+		return fmt.Errorf("unexpected ARI response (HTTP %d) - is ARI enabled in ari.conf?", resp.StatusCode)
+	}
+}
+
+func checkARIEventsChannel(host string, port int, user, pass string) error {
+	url := fmt.Sprintf("ws://%s:%d/ari/events?app=%s&subscribeAll=true", host, port, ariProbeApp)
+
+	header := http.Header{}
+	header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(user+":"+pass)))
+
+	dialer := websocket.Dialer{HandshakeTimeout: ariWebSocketTimeout}
+	conn, httpResp, err := dialer.Dial(url, header)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusUnauthorized {
+			return fmt.Errorf("ARI websocket authentication failed (HTTP 401) - check the ari.conf user/password")
+		}
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("ARI events endpoint not found - confirm [general] enabled=yes in ari.conf")
+		}
+		return fmt.Errorf("ARI websocket upgrade failed: %w", err)
+	}
+	defer conn.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		_, _, readErr := conn.ReadMessage()
+		done <- readErr
+	}()
+
+	select {
+	case readErr := <-done:
+		if readErr != nil {
+			return fmt.Errorf("ARI websocket closed before the Stasis channel came up: %w", readErr)
+		}
+		return nil
+	case <-time.After(ariWebSocketTimeout):
+		// No event arrived, but the upgrade succeeded and the connection is
+		// still open - the channel is reachable, there's just nothing to
+		// report with no active calls.
+		return nil
+	}
+}
+
+func classifyARIDialError(err error) error {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return fmt.Errorf("could not resolve Asterisk host: %w", dnsErr)
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return fmt.Errorf("connection refused - is Asterisk running with ARI listening on this port: %w", opErr)
+	}
+
+	return fmt.Errorf("ARI request failed: %w", err)
+}
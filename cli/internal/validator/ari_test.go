@@ -0,0 +1,153 @@
+package validator
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func splitHostPort(t *testing.T, serverURL string) (string, int) {
+	t.Helper()
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		t.Fatalf("parsing %q: %v", serverURL, err)
+	}
+	host, portStr, err := net.SplitHostPort(u.Host)
+	if err != nil {
+		t.Fatalf("splitting %q: %v", u.Host, err)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		t.Fatalf("parsing port %q: %v", portStr, err)
+	}
+	return host, port
+}
+
+func TestCheckARIInfo(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		wantErr    bool
+		wantSubstr string
+	}{
+		{name: "200 is accepted", statusCode: http.StatusOK},
+		{name: "401 reports the ari.conf credentials", statusCode: http.StatusUnauthorized, wantErr: true, wantSubstr: "ari.conf user/password"},
+		{name: "403 reports the ari.conf credentials", statusCode: http.StatusForbidden, wantErr: true, wantSubstr: "ari.conf user/password"},
+		{name: "500 is an unexpected response", statusCode: http.StatusInternalServerError, wantErr: true, wantSubstr: "unexpected ARI response"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotUser, gotPass string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotUser, gotPass, _ = r.BasicAuth()
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			host, port := splitHostPort(t, server.URL)
+			err := checkARIInfo(host, port, "asterisk", "secret")
+
+			if tt.wantErr && err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantSubstr) {
+				t.Errorf("error %q does not contain %q", err.Error(), tt.wantSubstr)
+			}
+			if gotUser != "asterisk" || gotPass != "secret" {
+				t.Errorf("basic auth = %q:%q, want %q:%q", gotUser, gotPass, "asterisk", "secret")
+			}
+		})
+	}
+}
+
+func TestCheckARIEventsChannel_SucceedsOnUpgrade(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("upgrade failed: %v", err)
+			return
+		}
+		defer conn.Close()
+		conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"WebSocketConnected"}`))
+	}))
+	defer server.Close()
+
+	host, port := splitHostPort(t, server.URL)
+	if err := checkARIEventsChannel(host, port, "asterisk", "secret"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestCheckARIEventsChannel_ClassifiesUpgradeFailures(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		wantSubstr string
+	}{
+		{name: "401 reports the ari.conf credentials", statusCode: http.StatusUnauthorized, wantSubstr: "ari.conf user/password"},
+		{name: "404 reports a disabled ARI endpoint", statusCode: http.StatusNotFound, wantSubstr: "enabled=yes in ari.conf"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			host, port := splitHostPort(t, server.URL)
+			err := checkARIEventsChannel(host, port, "asterisk", "secret")
+			if err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantSubstr) {
+				t.Errorf("error %q does not contain %q", err.Error(), tt.wantSubstr)
+			}
+		})
+	}
+}
+
+func TestClassifyARIDialError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantSubstr string
+	}{
+		{
+			name:       "DNS failure",
+			err:        &net.DNSError{Err: "no such host", Name: "bad.invalid", IsNotFound: true},
+			wantSubstr: "could not resolve Asterisk host",
+		},
+		{
+			name:       "connection refused",
+			err:        &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")},
+			wantSubstr: "connection refused",
+		},
+		{
+			name:       "anything else",
+			err:        errors.New("boom"),
+			wantSubstr: "ARI request failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := classifyARIDialError(tt.err)
+			if err == nil || !strings.Contains(err.Error(), tt.wantSubstr) {
+				t.Errorf("classifyARIDialError(%v) = %v, want substring %q", tt.err, err, tt.wantSubstr)
+			}
+		})
+	}
+}
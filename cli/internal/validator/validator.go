@@ -0,0 +1,115 @@
+// Package validator performs lightweight, read-only reachability and
+// authentication checks against the services the quickstart wizard and
+// `agent doctor` depend on, so operators find out about bad credentials
+// or unreachable hosts before containers start.
+package validator
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// AzureOptions carries the per-tenant details an Azure OpenAI deployment
+// needs on top of the API key: every Azure resource has its own endpoint,
+// deployment name, and API version, none of which apply to vanilla OpenAI.
+type AzureOptions struct {
+	BaseURL    string
+	Deployment string
+	APIVersion string
+}
+
+// ValidateAPIKey confirms that apiKey is accepted by provider's API using a
+// minimal, side-effect-free request. azure must be non-nil when provider is
+// "azure_openai" and is ignored otherwise.
+func ValidateAPIKey(provider, apiKey string, azure *AzureOptions) error {
+	switch provider {
+	case "openai_realtime":
+		return validateOpenAIKey(apiKey)
+	case "deepgram":
+		return validateDeepgramKey(apiKey)
+	case "google_live":
+		return validateGoogleKey(apiKey)
+	case "azure_openai":
+		if azure == nil {
+			return fmt.Errorf("azure options are required to validate an azure_openai key")
+		}
+		return validateAzureOpenAIKey(apiKey, *azure)
+	case "local_hybrid":
+		return nil
+	default:
+		return fmt.Errorf("unknown provider: %s", provider)
+	}
+}
+
+func validateOpenAIKey(apiKey string) error {
+	req, err := http.NewRequest(http.MethodGet, "https://api.openai.com/v1/models", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+	return doCheck(req)
+}
+
+func validateDeepgramKey(apiKey string) error {
+	req, err := http.NewRequest(http.MethodGet, "https://api.deepgram.com/v1/projects", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Token "+apiKey)
+	return doCheck(req)
+}
+
+func validateGoogleKey(apiKey string) error {
+	endpoint := "https://generativelanguage.googleapis.com/v1beta/models?key=" + url.QueryEscape(apiKey)
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	return doCheck(req)
+}
+
+// validateAzureOpenAIKey issues a minimal GET against the deployments
+// listing endpoint, which exists on every Azure OpenAI resource regardless
+// of which models are deployed, making it a cheap reachability+auth probe.
+func validateAzureOpenAIKey(apiKey string, opts AzureOptions) error {
+	if opts.BaseURL == "" {
+		return fmt.Errorf("azure base URL is required")
+	}
+	if opts.APIVersion == "" {
+		return fmt.Errorf("azure API version is required")
+	}
+
+	url := fmt.Sprintf("%s/openai/deployments?api-version=%s", opts.BaseURL, opts.APIVersion)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("api-key", apiKey)
+	return doCheck(req)
+}
+
+func doCheck(req *http.Request) error {
+	client := &http.Client{Timeout: defaultTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("authentication failed (HTTP %d)", resp.StatusCode)
+	case resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("endpoint not found (HTTP %d) - check the configured URL", resp.StatusCode)
+	default:
+		return fmt.Errorf("unexpected response (HTTP %d)", resp.StatusCode)
+	}
+}
@@ -0,0 +1,63 @@
+package validator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValidateAzureOpenAIKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		wantErr    bool
+		wantSubstr string
+	}{
+		{name: "200 is accepted", statusCode: http.StatusOK},
+		{name: "401 is an auth failure", statusCode: http.StatusUnauthorized, wantErr: true, wantSubstr: "authentication failed"},
+		{name: "403 is an auth failure", statusCode: http.StatusForbidden, wantErr: true, wantSubstr: "authentication failed"},
+		{name: "404 is reported as a bad URL", statusCode: http.StatusNotFound, wantErr: true, wantSubstr: "endpoint not found"},
+		{name: "500 is an unexpected response", statusCode: http.StatusInternalServerError, wantErr: true, wantSubstr: "unexpected response"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotAPIKeyHeader, gotAPIVersion string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotAPIKeyHeader = r.Header.Get("api-key")
+				gotAPIVersion = r.URL.Query().Get("api-version")
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			opts := AzureOptions{BaseURL: server.URL, Deployment: "gpt-4o", APIVersion: "2024-10-01-preview"}
+			err := validateAzureOpenAIKey("test-key", opts)
+
+			if tt.wantErr && err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if tt.wantErr && tt.wantSubstr != "" && !strings.Contains(err.Error(), tt.wantSubstr) {
+				t.Errorf("error %q does not contain %q", err.Error(), tt.wantSubstr)
+			}
+			if gotAPIKeyHeader != "test-key" {
+				t.Errorf("api-key header = %q, want %q", gotAPIKeyHeader, "test-key")
+			}
+			if gotAPIVersion != opts.APIVersion {
+				t.Errorf("api-version query = %q, want %q", gotAPIVersion, opts.APIVersion)
+			}
+		})
+	}
+}
+
+func TestValidateAzureOpenAIKey_RequiresBaseURLAndAPIVersion(t *testing.T) {
+	if err := validateAzureOpenAIKey("key", AzureOptions{APIVersion: "2024-10-01-preview"}); err == nil {
+		t.Error("expected an error when BaseURL is empty")
+	}
+	if err := validateAzureOpenAIKey("key", AzureOptions{BaseURL: "https://tenant.openai.azure.com"}); err == nil {
+		t.Error("expected an error when APIVersion is empty")
+	}
+}